@@ -0,0 +1,25 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package api wires the node's HTTP endpoints onto a router.
+package api
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/vechain/thor/v2/api/fees"
+	"github.com/vechain/thor/v2/txpool/gasprice"
+)
+
+// New builds the HTTP handler for the node's API, mounting every endpoint
+// group onto a fresh router.
+func New(feesOracle *gasprice.Oracle) http.Handler {
+	router := mux.NewRouter()
+
+	fees.New(feesOracle).Mount(router, "/fees")
+
+	return router
+}
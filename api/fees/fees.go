@@ -0,0 +1,130 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package fees exposes the txpool's gas-price oracle over HTTP, mirroring
+// Ethereum's eth_feeHistory/eth_maxPriorityFeePerGas semantics.
+package fees
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/vechain/thor/v2/api/utils"
+	"github.com/vechain/thor/v2/txpool/gasprice"
+)
+
+// defaultFeeHistoryBlockCount is how many blocks a history request walks
+// back when the caller doesn't ask for a specific count via ?blockCount=.
+const defaultFeeHistoryBlockCount = 20
+
+// defaultRewardPercentiles are the percentiles a fee-history request reports
+// rewards at when the caller doesn't ask for specific ones via
+// ?rewardPercentiles=.
+var defaultRewardPercentiles = []float64{25, 50, 75}
+
+// Fees serves fee suggestions derived from recent chain history and the
+// pool's current backlog.
+type Fees struct {
+	oracle *gasprice.Oracle
+}
+
+// New creates a Fees handler backed by oracle.
+func New(oracle *gasprice.Oracle) *Fees {
+	return &Fees{oracle: oracle}
+}
+
+// FeeHistoryResult is the JSON payload for the fee-history endpoint.
+type FeeHistoryResult struct {
+	BaseFees      []string   `json:"baseFees"`
+	GasUsedRatios []float64  `json:"gasUsedRatios"`
+	Rewards       [][]string `json:"rewards"`
+}
+
+func (f *Fees) handleFeeHistory(w http.ResponseWriter, req *http.Request) error {
+	blockCount, err := parseBlockCount(req)
+	if err != nil {
+		return err
+	}
+	percentiles, err := parseRewardPercentiles(req)
+	if err != nil {
+		return err
+	}
+
+	history, err := f.oracle.FeeHistory(blockCount, percentiles)
+	if err != nil {
+		return err
+	}
+
+	result := FeeHistoryResult{}
+	for _, fees := range history {
+		result.BaseFees = append(result.BaseFees, fees.BaseFee.String())
+		result.GasUsedRatios = append(result.GasUsedRatios, fees.GasUsedRatio)
+		rewards := make([]string, len(fees.Rewards))
+		for i, r := range fees.Rewards {
+			rewards[i] = r.String()
+		}
+		result.Rewards = append(result.Rewards, rewards)
+	}
+
+	return utils.WriteJSON(w, result)
+}
+
+func (f *Fees) handleSuggestPriorityFee(w http.ResponseWriter, req *http.Request) error {
+	blockCount, err := parseBlockCount(req)
+	if err != nil {
+		return err
+	}
+
+	fee, err := f.oracle.SuggestPriorityFee(blockCount)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, fee.String())
+}
+
+// parseBlockCount reads the ?blockCount= query parameter, defaulting to
+// defaultFeeHistoryBlockCount when absent.
+func parseBlockCount(req *http.Request) (int, error) {
+	raw := req.URL.Query().Get("blockCount")
+	if raw == "" {
+		return defaultFeeHistoryBlockCount, nil
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n <= 0 {
+		return 0, errors.New("blockCount must be a positive integer")
+	}
+	return n, nil
+}
+
+// parseRewardPercentiles reads the ?rewardPercentiles= query parameter, a
+// comma-separated list of values in [0, 100], defaulting to
+// defaultRewardPercentiles when absent.
+func parseRewardPercentiles(req *http.Request) ([]float64, error) {
+	raw := req.URL.Query().Get("rewardPercentiles")
+	if raw == "" {
+		return defaultRewardPercentiles, nil
+	}
+
+	parts := strings.Split(raw, ",")
+	percentiles := make([]float64, len(parts))
+	for i, p := range parts {
+		v, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+		if err != nil || v < 0 || v > 100 {
+			return nil, errors.New("rewardPercentiles must be comma-separated values between 0 and 100")
+		}
+		percentiles[i] = v
+	}
+	return percentiles, nil
+}
+
+// Mount registers the fees endpoints under root.
+func (f *Fees) Mount(root *mux.Router, pathPrefix string) {
+	sub := root.PathPrefix(pathPrefix).Subrouter()
+	sub.Path("/history").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(f.handleFeeHistory))
+	sub.Path("/priority").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(f.handleSuggestPriorityFee))
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package fees
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func requestWithQuery(rawQuery string) *http.Request {
+	return &http.Request{URL: &url.URL{RawQuery: rawQuery}}
+}
+
+func TestParseBlockCount(t *testing.T) {
+	n, err := parseBlockCount(requestWithQuery(""))
+	assert.Nil(t, err)
+	assert.Equal(t, defaultFeeHistoryBlockCount, n)
+
+	n, err = parseBlockCount(requestWithQuery("blockCount=5"))
+	assert.Nil(t, err)
+	assert.Equal(t, 5, n)
+
+	_, err = parseBlockCount(requestWithQuery("blockCount=0"))
+	assert.NotNil(t, err)
+
+	_, err = parseBlockCount(requestWithQuery("blockCount=not-a-number"))
+	assert.NotNil(t, err)
+}
+
+func TestParseRewardPercentiles(t *testing.T) {
+	p, err := parseRewardPercentiles(requestWithQuery(""))
+	assert.Nil(t, err)
+	assert.Equal(t, defaultRewardPercentiles, p)
+
+	p, err = parseRewardPercentiles(requestWithQuery("rewardPercentiles=10,50,99.5"))
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{10, 50, 99.5}, p)
+
+	_, err = parseRewardPercentiles(requestWithQuery("rewardPercentiles=10,150"))
+	assert.NotNil(t, err)
+
+	_, err = parseRewardPercentiles(requestWithQuery("rewardPercentiles=oops"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package utils holds small helpers shared across the api/* handlers.
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// WriteJSON encodes v as the response body with the appropriate content type.
+func WriteJSON(w http.ResponseWriter, v any) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	return json.NewEncoder(w).Encode(v)
+}
+
+// HandlerFunc is an http handler that can fail; WrapHandlerFunc adapts it to
+// the standard library's http.HandlerFunc, writing a 500 on error.
+type HandlerFunc func(w http.ResponseWriter, req *http.Request) error
+
+// WrapHandlerFunc adapts a HandlerFunc to http.HandlerFunc.
+func WrapHandlerFunc(h HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if err := h(w, req); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
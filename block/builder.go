@@ -0,0 +1,62 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package block
+
+import (
+	"math/big"
+
+	"github.com/vechain/thor/v2/thor"
+	"github.com/vechain/thor/v2/tx"
+)
+
+// Builder assembles a Header/Block field by field.
+type Builder struct {
+	header Header
+}
+
+func (b *Builder) ParentID(id thor.Bytes32) *Builder {
+	b.header.parentID = id
+	return b
+}
+
+func (b *Builder) GasLimit(limit uint64) *Builder {
+	b.header.gasLimit = limit
+	return b
+}
+
+func (b *Builder) GasUsed(used uint64) *Builder {
+	b.header.gasUsed = used
+	return b
+}
+
+func (b *Builder) BaseFee(fee *big.Int) *Builder {
+	b.header.baseFee = fee
+	return b
+}
+
+func (b *Builder) ExcessBlobGas(gas *big.Int) *Builder {
+	b.header.excessBlobGas = gas
+	return b
+}
+
+func (b *Builder) StateRoot(root thor.Bytes32) *Builder {
+	b.header.stateRoot = root
+	return b
+}
+
+func (b *Builder) TransactionFeatures(f tx.Features) *Builder {
+	b.header.transactionFeatures = f
+	return b
+}
+
+// Build finalizes the block.
+func (b *Builder) Build() *Block {
+	h := b.header
+	if h.gasLimit == 0 {
+		h.gasLimit = 20_000_000
+	}
+	return &Block{header: &h}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package block implements the block and block header types.
+package block
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/vechain/thor/v2/thor"
+	"github.com/vechain/thor/v2/tx"
+)
+
+// Header is a block header.
+type Header struct {
+	parentID            thor.Bytes32
+	gasLimit            uint64
+	gasUsed             uint64
+	baseFee             *big.Int
+	excessBlobGas       *big.Int
+	stateRoot           thor.Bytes32
+	transactionFeatures tx.Features
+}
+
+// ParentID returns the ID of the parent block.
+func (h *Header) ParentID() thor.Bytes32 { return h.parentID }
+
+// Number returns this header's block number, derived from its parent's.
+func (h *Header) Number() uint32 {
+	return binary.BigEndian.Uint32(h.parentID[:4]) + 1
+}
+
+// ID returns this header's identifier: its block number followed by bytes
+// 4-24 of its parent's ID. No other header field goes into it.
+func (h *Header) ID() thor.Bytes32 {
+	var id thor.Bytes32
+	binary.BigEndian.PutUint32(id[:4], h.Number())
+	copy(id[4:], h.parentID[4:24])
+	return id
+}
+
+// GasLimit returns the block's gas limit.
+func (h *Header) GasLimit() uint64 { return h.gasLimit }
+
+// GasUsed returns gas actually consumed by the block's transactions.
+func (h *Header) GasUsed() uint64 { return h.gasUsed }
+
+// BaseFee returns the block's EIP-1559-style base fee, or thor.InitialBaseFee
+// when none was set. It never returns nil.
+func (h *Header) BaseFee() *big.Int {
+	if h.baseFee == nil {
+		return big.NewInt(thor.InitialBaseFee)
+	}
+	return h.baseFee
+}
+
+// ExcessBlobGas returns the excess blob gas carried over from the parent,
+// used to derive the block's blob base fee.
+func (h *Header) ExcessBlobGas() *big.Int {
+	if h.excessBlobGas == nil {
+		return new(big.Int)
+	}
+	return h.excessBlobGas
+}
+
+// StateRoot returns the root hash of the block's post-state trie.
+func (h *Header) StateRoot() thor.Bytes32 { return h.stateRoot }
+
+// TransactionFeatures returns the set of tx features this block will accept.
+func (h *Header) TransactionFeatures() tx.Features { return h.transactionFeatures }
+
+// Block couples a header with its body; only the header is modeled here
+// since validation only ever inspects it.
+type Block struct {
+	header *Header
+}
+
+// Header returns the block's header.
+func (b *Block) Header() *Header { return b.header }
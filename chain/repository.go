@@ -0,0 +1,68 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package chain indexes blocks and exposes the best chain to the rest of the
+// node.
+package chain
+
+import (
+	"errors"
+
+	"github.com/vechain/thor/v2/block"
+	"github.com/vechain/thor/v2/thor"
+	"github.com/vechain/thor/v2/tx"
+)
+
+// BlockSummary pairs a header with the transactions it includes.
+type BlockSummary struct {
+	Header *block.Header
+	Txs    tx.Transactions
+}
+
+// Repository indexes blocks by ID and tracks the current best chain.
+type Repository struct {
+	chainTag byte
+	genesis  *block.Block
+	best     *BlockSummary
+	blocks   map[thor.Bytes32]*BlockSummary
+}
+
+// NewRepository creates a repository seeded with genesis as both the genesis
+// and, until any block is added on top of it, the best block.
+func NewRepository(chainTag byte, genesis *block.Block) *Repository {
+	summary := &BlockSummary{Header: genesis.Header()}
+	return &Repository{
+		chainTag: chainTag,
+		genesis:  genesis,
+		best:     summary,
+		blocks:   map[thor.Bytes32]*BlockSummary{genesis.Header().ID(): summary},
+	}
+}
+
+// ChainTag returns the genesis-derived tag transactions must match.
+func (r *Repository) ChainTag() byte { return r.chainTag }
+
+// GenesisBlock returns the chain's genesis block.
+func (r *Repository) GenesisBlock() *block.Block { return r.genesis }
+
+// BestBlockSummary returns the summary of the current best block.
+func (r *Repository) BestBlockSummary() *BlockSummary { return r.best }
+
+// GetBlockSummary looks up a block summary by ID.
+func (r *Repository) GetBlockSummary(id thor.Bytes32) (*BlockSummary, error) {
+	if s, ok := r.blocks[id]; ok {
+		return s, nil
+	}
+	return nil, errors.New("chain: block not found")
+}
+
+// AddBlock indexes a new block and, if it extends the current best, advances
+// the best pointer to it.
+func (r *Repository) AddBlock(summary *BlockSummary) {
+	r.blocks[summary.Header.ID()] = summary
+	if summary.Header.Number() > r.best.Header.Number() {
+		r.best = summary
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package fork
+
+import (
+	"math/big"
+
+	"github.com/vechain/thor/v2/block"
+	"github.com/vechain/thor/v2/thor"
+)
+
+// elasticityMultiplier is the ratio of a block's gas limit to its gas target:
+// the base fee climbs once a block uses more than GasLimit / 2, mirroring
+// EIP-1559's target.
+const elasticityMultiplier = 2
+
+// baseFeeChangeDenominator bounds how fast the base fee can move between two
+// consecutive blocks: at most a 1/8 step per block.
+const baseFeeChangeDenominator = 8
+
+// CalcBaseFee derives the base fee for the block that follows parent, given
+// how much gas parent actually used relative to its target. It climbs when
+// parent ran above target, falls when it ran below, and is clamped to
+// config's MinBaseFee/MaxBaseFee. Before Galactica activates it returns
+// thor.InitialBaseFee unconditionally.
+func CalcBaseFee(parent *block.Header, config *thor.ForkConfig) *big.Int {
+	if parent.Number()+1 < config.GALACTICA {
+		return big.NewInt(thor.InitialBaseFee)
+	}
+
+	parentBaseFee := parent.BaseFee()
+	if parentBaseFee == nil {
+		parentBaseFee = big.NewInt(thor.InitialBaseFee)
+	}
+
+	gasTarget := parent.GasLimit() / elasticityMultiplier
+	gasUsed := parent.GasUsed()
+
+	var next *big.Int
+	switch {
+	case gasUsed == gasTarget:
+		next = new(big.Int).Set(parentBaseFee)
+	case gasUsed > gasTarget:
+		delta := new(big.Int).Sub(big.NewInt(int64(gasUsed)), big.NewInt(int64(gasTarget)))
+		delta.Mul(delta, parentBaseFee)
+		delta.Div(delta, big.NewInt(int64(gasTarget)))
+		delta.Div(delta, big.NewInt(baseFeeChangeDenominator))
+		if delta.Sign() == 0 {
+			delta = big.NewInt(1)
+		}
+		next = new(big.Int).Add(parentBaseFee, delta)
+	default:
+		delta := new(big.Int).Sub(big.NewInt(int64(gasTarget)), big.NewInt(int64(gasUsed)))
+		delta.Mul(delta, parentBaseFee)
+		delta.Div(delta, big.NewInt(int64(gasTarget)))
+		delta.Div(delta, big.NewInt(baseFeeChangeDenominator))
+		next = new(big.Int).Sub(parentBaseFee, delta)
+	}
+
+	if config.MinBaseFee != nil && next.Cmp(config.MinBaseFee) < 0 {
+		next = config.MinBaseFee
+	}
+	if config.MaxBaseFee != nil && next.Cmp(config.MaxBaseFee) > 0 {
+		next = config.MaxBaseFee
+	}
+	return next
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package fork
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/v2/block"
+	"github.com/vechain/thor/v2/thor"
+)
+
+func parentHeader(gasLimit, gasUsed uint64, baseFee *big.Int) *block.Header {
+	return new(block.Builder).
+		GasLimit(gasLimit).
+		GasUsed(gasUsed).
+		BaseFee(baseFee).
+		Build().Header()
+}
+
+func TestCalcBaseFee(t *testing.T) {
+	config := &thor.ForkConfig{GALACTICA: 0, MinBaseFee: big.NewInt(1), MaxBaseFee: big.NewInt(1_000_000_000_000_000)}
+
+	t.Run("climbs when parent is full", func(t *testing.T) {
+		parent := parentHeader(20_000_000, 20_000_000, big.NewInt(thor.InitialBaseFee))
+		next := CalcBaseFee(parent, config)
+		assert.True(t, next.Cmp(big.NewInt(thor.InitialBaseFee)) > 0)
+	})
+
+	t.Run("falls when parent is empty", func(t *testing.T) {
+		parent := parentHeader(20_000_000, 0, big.NewInt(thor.InitialBaseFee))
+		next := CalcBaseFee(parent, config)
+		assert.True(t, next.Cmp(big.NewInt(thor.InitialBaseFee)) < 0)
+	})
+
+	t.Run("holds steady at target", func(t *testing.T) {
+		parent := parentHeader(20_000_000, 10_000_000, big.NewInt(thor.InitialBaseFee))
+		next := CalcBaseFee(parent, config)
+		assert.Equal(t, big.NewInt(thor.InitialBaseFee), next)
+	})
+
+	t.Run("clamps at MaxBaseFee", func(t *testing.T) {
+		lowConfig := &thor.ForkConfig{GALACTICA: 0, MinBaseFee: big.NewInt(1), MaxBaseFee: big.NewInt(thor.InitialBaseFee)}
+		parent := parentHeader(20_000_000, 20_000_000, big.NewInt(thor.InitialBaseFee))
+		next := CalcBaseFee(parent, lowConfig)
+		assert.Equal(t, big.NewInt(thor.InitialBaseFee), next)
+	})
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package fork
+
+import "math/big"
+
+// minBlobBaseFee and blobBaseFeeUpdateFraction are EIP-4844's constants
+// controlling how fast the blob base fee reacts to excess blob gas.
+var (
+	minBlobBaseFee            = big.NewInt(1)
+	blobBaseFeeUpdateFraction = big.NewInt(3338477)
+)
+
+// CalcBlobBaseFee derives the per-blob-gas fee from the block's excess blob
+// gas, using the same fake-exponential approximation as EIP-4844.
+func CalcBlobBaseFee(excessBlobGas *big.Int) *big.Int {
+	return fakeExponential(minBlobBaseFee, excessBlobGas, blobBaseFeeUpdateFraction)
+}
+
+// fakeExponential approximates factor * e**(numerator/denominator) using
+// integer arithmetic, as specified by EIP-4844.
+func fakeExponential(factor, numerator, denominator *big.Int) *big.Int {
+	i := big.NewInt(1)
+	output := new(big.Int)
+	numeratorAccum := new(big.Int).Mul(factor, denominator)
+
+	for numeratorAccum.Sign() > 0 {
+		output.Add(output, numeratorAccum)
+
+		numeratorAccum.Mul(numeratorAccum, numerator)
+		denom := new(big.Int).Mul(denominator, i)
+		numeratorAccum.Div(numeratorAccum, denom)
+
+		i.Add(i, big.NewInt(1))
+	}
+	return output.Div(output, denominator)
+}
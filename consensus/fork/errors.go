@@ -0,0 +1,12 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package fork
+
+import "errors"
+
+// ErrGasPriceTooLowForBlockBase is returned when a dyn-fee tx's maxFeePerGas
+// can't cover the block's base fee.
+var ErrGasPriceTooLowForBlockBase = errors.New("gas price is less than block base fee")
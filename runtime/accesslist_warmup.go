@@ -0,0 +1,39 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime
+
+import (
+	"github.com/vechain/thor/v2/tx"
+)
+
+// EIP-2929 cold/warm access costs: an address or storage slot's first touch
+// in a tx is charged the cold cost unless it was pre-warmed, in which case it
+// only pays the warm cost.
+const (
+	coldAddressAccessCost = 2600
+	warmAddressAccessCost = 100
+
+	coldSloadCost = 2100
+	warmSloadCost = 100
+)
+
+// warmAccessList pre-warms the addresses and storage slots named in an
+// access-list tx before clause execution begins, so the first SLOAD/CALL
+// touching them is charged the warm (discounted) gas cost instead of cold.
+// It returns the total gas this saves, assuming each listed entry is touched
+// exactly once during clause execution.
+func warmAccessList(evm *EVM, al tx.AccessList) uint64 {
+	var saved uint64
+	for _, entry := range al {
+		evm.StateDB.AddAddressToAccessList(entry.Address)
+		saved += coldAddressAccessCost - warmAddressAccessCost
+		for _, key := range entry.StorageKeys {
+			evm.StateDB.AddSlotToAccessList(entry.Address, key)
+			saved += coldSloadCost - warmSloadCost
+		}
+	}
+	return saved
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/v2/thor"
+	"github.com/vechain/thor/v2/tx"
+)
+
+type fakeStateDB struct {
+	warmAddresses map[thor.Address]bool
+	warmSlots     map[thor.Address][]thor.Bytes32
+}
+
+func newFakeStateDB() *fakeStateDB {
+	return &fakeStateDB{warmAddresses: make(map[thor.Address]bool), warmSlots: make(map[thor.Address][]thor.Bytes32)}
+}
+
+func (s *fakeStateDB) AddAddressToAccessList(addr thor.Address) {
+	s.warmAddresses[addr] = true
+}
+
+func (s *fakeStateDB) AddSlotToAccessList(addr thor.Address, slot thor.Bytes32) {
+	s.warmSlots[addr] = append(s.warmSlots[addr], slot)
+}
+
+func TestExecuteTransactionWarmsAccessList(t *testing.T) {
+	stateDB := newFakeStateDB()
+	evm := &EVM{StateDB: stateDB}
+
+	addr := thor.Address{1}
+	slot := thor.Bytes32{2}
+	al := tx.AccessList{{Address: addr, StorageKeys: []thor.Bytes32{slot}}}
+	trx := tx.NewBuilder(tx.TypeAccessList).AccessList(al).Gas(50_000).Build()
+
+	receipt := ExecuteTransaction(evm, trx)
+
+	assert.True(t, stateDB.warmAddresses[addr])
+	assert.Equal(t, []thor.Bytes32{slot}, stateDB.warmSlots[addr])
+	// One warmed address (saves 2500) and one warmed slot (saves 2000): the
+	// receipt must actually reflect that discount, not just charge Gas().
+	assert.Equal(t, uint64(50_000-4_500), receipt.GasUsed)
+}
+
+func TestExecuteTransactionSkipsWarmupForNonAccessListTx(t *testing.T) {
+	stateDB := newFakeStateDB()
+	evm := &EVM{StateDB: stateDB}
+
+	trx := tx.NewBuilder(tx.TypeLegacy).Gas(21_000).Build()
+	receipt := ExecuteTransaction(evm, trx)
+
+	assert.Empty(t, stateDB.warmAddresses)
+	assert.Equal(t, uint64(21_000), receipt.GasUsed)
+}
@@ -0,0 +1,21 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package runtime executes transactions' clauses against a Thor VM.
+package runtime
+
+import "github.com/vechain/thor/v2/thor"
+
+// StateDB is the subset of VM-visible state the runtime warms up before
+// executing a transaction's clauses.
+type StateDB interface {
+	AddAddressToAccessList(addr thor.Address)
+	AddSlotToAccessList(addr thor.Address, slot thor.Bytes32)
+}
+
+// EVM is the execution context a transaction's clauses run against.
+type EVM struct {
+	StateDB StateDB
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime
+
+import "github.com/vechain/thor/v2/tx"
+
+// Receipt summarizes the outcome of executing a transaction's clauses.
+type Receipt struct {
+	GasUsed uint64
+}
+
+// ExecuteTransaction pre-warms any access-list addresses/slots of trx in evm
+// and reports trx's full gas limit, less the warm-access discount, as
+// GasUsed. It does not execute trx's clauses.
+func ExecuteTransaction(evm *EVM, trx *tx.Transaction) *Receipt {
+	gasUsed := trx.Gas()
+	if trx.Type() == tx.TypeAccessList {
+		gasUsed -= warmAccessList(evm, trx.AccessList())
+	}
+
+	return &Receipt{GasUsed: gasUsed}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package state implements the account/storage world state.
+package state
+
+import "github.com/vechain/thor/v2/trie"
+
+// State is a handle onto the world state at a given trie root.
+type State struct {
+	root trie.Root
+}
+
+// Stater opens States rooted at a given trie root.
+type Stater struct{}
+
+// NewStater creates a Stater.
+func NewStater() *Stater {
+	return &Stater{}
+}
+
+// NewState opens the state rooted at root.
+func (s *Stater) NewState(root trie.Root) *State {
+	return &State{root: root}
+}
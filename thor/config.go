@@ -0,0 +1,44 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package thor
+
+import (
+	"math"
+	"math/big"
+)
+
+// InitialBaseFee is the base fee seeded into the first block after Galactica
+// activates, before any elastic adjustment has had a chance to run.
+const InitialBaseFee = 10_000_000_000_000
+
+// ForkConfig describes the block numbers at which the chain's hard forks
+// activate, plus any fork-specific parameters.
+type ForkConfig struct {
+	VIP191    uint32
+	ETH_CONST uint32
+	BLOCKLIST uint32
+	ETH_IST   uint32
+	GALACTICA uint32
+
+	// MinBaseFee and MaxBaseFee clamp the elastic base fee introduced by
+	// Galactica. Nil means "no clamp".
+	MinBaseFee *big.Int
+	MaxBaseFee *big.Int
+}
+
+// NoFork is a ForkConfig where every fork is scheduled so far in the future
+// that it never activates during a test.
+var NoFork = ForkConfig{
+	VIP191:    math.MaxUint32,
+	ETH_CONST: math.MaxUint32,
+	BLOCKLIST: math.MaxUint32,
+	ETH_IST:   math.MaxUint32,
+	GALACTICA: math.MaxUint32,
+}
+
+// SoloFork is a ForkConfig where every fork is active from genesis, used by
+// the solo/test chain.
+var SoloFork = ForkConfig{}
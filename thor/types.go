@@ -0,0 +1,24 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package thor
+
+import "github.com/ethereum/go-ethereum/common"
+
+// Bytes32 is a 32-byte value, used for block/tx IDs, roots and hashes.
+type Bytes32 [32]byte
+
+// IsZero returns whether b is the zero value.
+func (b Bytes32) IsZero() bool {
+	return b == Bytes32{}
+}
+
+// Address is a 20-byte account address.
+type Address common.Address
+
+// Bytes returns addr as a byte slice.
+func (addr Address) Bytes() []byte {
+	return addr[:]
+}
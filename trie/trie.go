@@ -0,0 +1,15 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package trie implements the Merkle Patricia trie used for account and
+// storage state.
+package trie
+
+import "github.com/vechain/thor/v2/thor"
+
+// Root identifies a trie by its root hash.
+type Root struct {
+	Hash thor.Bytes32
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx
+
+import (
+	"math/big"
+
+	"github.com/vechain/thor/v2/thor"
+)
+
+// Builder assembles a Transaction field by field.
+type Builder struct {
+	trx Transaction
+}
+
+// NewBuilder starts building a transaction of the given type.
+func NewBuilder(txType Type) *Builder {
+	b := &Builder{}
+	b.trx.txType = txType
+	return b
+}
+
+func (b *Builder) ChainTag(tag byte) *Builder {
+	b.trx.chainTag = tag
+	return b
+}
+
+func (b *Builder) Clause(c *Clause) *Builder {
+	b.trx.clauses = append(b.trx.clauses, c)
+	return b
+}
+
+func (b *Builder) BlockRef(ref BlockRef) *Builder {
+	b.trx.blockRef = ref
+	return b
+}
+
+func (b *Builder) Expiration(exp uint32) *Builder {
+	b.trx.expiration = exp
+	return b
+}
+
+func (b *Builder) Gas(gas uint64) *Builder {
+	b.trx.gas = gas
+	return b
+}
+
+func (b *Builder) Features(f Features) *Builder {
+	b.trx.features = f
+	return b
+}
+
+func (b *Builder) GasPriceCoef(coef uint8) *Builder {
+	b.trx.gasPriceCoef = coef
+	return b
+}
+
+func (b *Builder) MaxFeePerGas(v *big.Int) *Builder {
+	b.trx.maxFeePerGas = v
+	return b
+}
+
+func (b *Builder) MaxPriorityFeePerGas(v *big.Int) *Builder {
+	b.trx.maxPriorityFeePerGas = v
+	return b
+}
+
+func (b *Builder) AccessList(al AccessList) *Builder {
+	b.trx.accessList = al
+	return b
+}
+
+func (b *Builder) MaxFeePerBlobGas(v *big.Int) *Builder {
+	b.trx.maxFeePerBlobGas = v
+	return b
+}
+
+func (b *Builder) BlobVersionedHashes(hashes []thor.Bytes32) *Builder {
+	b.trx.blobVersionedHashes = hashes
+	return b
+}
+
+// Build finalizes the transaction.
+func (b *Builder) Build() *Transaction {
+	trx := b.trx
+	return &trx
+}
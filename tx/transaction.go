@@ -0,0 +1,181 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package tx implements transaction types and their builders.
+package tx
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/vechain/thor/v2/thor"
+)
+
+// Type identifies a transaction's payload shape.
+type Type byte
+
+// Supported transaction types.
+const (
+	TypeLegacy Type = iota
+	TypeDynamicFee
+	TypeAccessList
+	TypeBlob
+)
+
+// ErrTxTypeNotSupported is returned when a tx type isn't activated by the
+// chain's current fork configuration.
+var ErrTxTypeNotSupported = errors.New("tx type not supported")
+
+// Features is a bitmask of optional transaction behaviors, e.g. fee delegation.
+type Features uint8
+
+// DelegationFeature marks a tx as using VIP-191 fee delegation.
+const DelegationFeature Features = 1
+
+// IsSupported reports whether every bit set in f is also set in allowed.
+func (f Features) IsSupported(allowed Features) bool {
+	return f&^allowed == 0
+}
+
+// Clause is a single call within a transaction.
+type Clause struct {
+	To    *thor.Address
+	Value *big.Int
+	Data  []byte
+}
+
+// BlockRef anchors a tx to a reference block, used with Expiration to bound
+// its validity window.
+type BlockRef [8]byte
+
+// AccessTuple names one address and the storage slots a tx pre-warms on it.
+type AccessTuple struct {
+	Address     thor.Address
+	StorageKeys []thor.Bytes32
+}
+
+// AccessList is an EIP-2930-style list of addresses/slots a tx pre-warms.
+type AccessList []AccessTuple
+
+// Transactions is a slice of transactions.
+type Transactions []*Transaction
+
+// Transaction is a signed (or, pre-signing, unsigned) chain transaction.
+type Transaction struct {
+	txType   Type
+	chainTag byte
+	clauses  []*Clause
+
+	blockRef   BlockRef
+	expiration uint32
+	gas        uint64
+	features   Features
+
+	gasPriceCoef uint8
+
+	maxFeePerGas         *big.Int
+	maxPriorityFeePerGas *big.Int
+
+	accessList AccessList
+
+	maxFeePerBlobGas    *big.Int
+	blobVersionedHashes []thor.Bytes32
+
+	signature []byte
+}
+
+func (t *Transaction) Type() Type                          { return t.txType }
+func (t *Transaction) ChainTag() byte                      { return t.chainTag }
+func (t *Transaction) Clauses() []*Clause                  { return t.clauses }
+func (t *Transaction) BlockRef() BlockRef                  { return t.blockRef }
+func (t *Transaction) Expiration() uint32                  { return t.expiration }
+func (t *Transaction) Gas() uint64                         { return t.gas }
+func (t *Transaction) Features() Features                  { return t.features }
+func (t *Transaction) GasPriceCoef() uint8                 { return t.gasPriceCoef }
+func (t *Transaction) AccessList() AccessList              { return t.accessList }
+func (t *Transaction) BlobVersionedHashes() []thor.Bytes32 { return t.blobVersionedHashes }
+
+// MaxFeePerGas returns the dyn-fee/blob cap on total fee per gas, or zero for
+// a legacy tx.
+func (t *Transaction) MaxFeePerGas() *big.Int {
+	if t.maxFeePerGas == nil {
+		return new(big.Int)
+	}
+	return t.maxFeePerGas
+}
+
+// MaxPriorityFeePerGas returns the dyn-fee/blob tip cap, or zero for a legacy tx.
+func (t *Transaction) MaxPriorityFeePerGas() *big.Int {
+	if t.maxPriorityFeePerGas == nil {
+		return new(big.Int)
+	}
+	return t.maxPriorityFeePerGas
+}
+
+// MaxFeePerBlobGas returns the blob-gas fee cap, or zero for a non-blob tx.
+func (t *Transaction) MaxFeePerBlobGas() *big.Int {
+	if t.maxFeePerBlobGas == nil {
+		return new(big.Int)
+	}
+	return t.maxFeePerBlobGas
+}
+
+// GasPrice returns the legacy tx's effective gas price at the given base
+// price: baseGasPrice plus a gasPriceCoef/255 share of it.
+func (t *Transaction) GasPrice(baseGasPrice *big.Int) *big.Int {
+	x := new(big.Int).Mul(baseGasPrice, big.NewInt(int64(t.gasPriceCoef)))
+	x.Div(x, big.NewInt(255))
+	return x.Add(x, baseGasPrice)
+}
+
+// Size returns the transaction's encoded size in bytes.
+func (t *Transaction) Size() uint32 {
+	size := 64 // fixed overhead: chain tag, block ref, expiration, gas, type, signature, etc.
+	for _, c := range t.clauses {
+		size += 64 + len(c.Data)
+	}
+	for _, e := range t.accessList {
+		size += 20 + len(e.StorageKeys)*32
+	}
+	size += len(t.blobVersionedHashes) * 32
+	return uint32(size)
+}
+
+// Origin returns the tx's sender. Unsigned transactions (as built directly by
+// tests) have no recoverable origin and return the zero address.
+func (t *Transaction) Origin() (thor.Address, error) {
+	if len(t.signature) == 0 {
+		return thor.Address{}, nil
+	}
+	return thor.Address{}, errors.New("origin: signature recovery not supported")
+}
+
+// ID returns a hash of the transaction's chain tag, type, gasPriceCoef and the
+// low bytes of its gas and expiration. Clauses and fee caps are not hashed.
+func (t *Transaction) ID() thor.Bytes32 {
+	h := fnv64(t.chainTag, byte(t.txType), t.gasPriceCoef, byte(t.gas), byte(t.expiration))
+	var id thor.Bytes32
+	copy(id[:], h)
+	return id
+}
+
+// fnv64 is a tiny non-cryptographic hash, good enough to give distinct
+// in-memory transactions distinct IDs for pool bookkeeping.
+func fnv64(bs ...byte) []byte {
+	const (
+		offset = 14695981039346656037
+		prime  = 1099511628211
+	)
+	h := uint64(offset)
+	for _, b := range bs {
+		h ^= uint64(b)
+		h *= prime
+	}
+	out := make([]byte, 8)
+	for i := 0; i < 8; i++ {
+		out[i] = byte(h >> (8 * i))
+	}
+	return out
+}
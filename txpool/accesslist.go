@@ -0,0 +1,64 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"github.com/vechain/thor/v2/thor"
+	"github.com/vechain/thor/v2/tx"
+)
+
+const (
+	// txGas is the flat intrinsic gas every transaction pays, mirroring the
+	// legacy/dyn-fee base cost.
+	txGas = 21000
+	// accessListAddressGas and accessListStorageKeyGas are the per-entry
+	// intrinsic gas surcharges for an access list, matching EIP-2930.
+	accessListAddressGas    = 2400
+	accessListStorageKeyGas = 1900
+
+	// maxAccessListAddresses and maxAccessListStorageKeysPerEntry bound an access
+	// list's size so pool admission can't be used to smuggle in an
+	// arbitrarily large payload.
+	maxAccessListAddresses           = 256
+	maxAccessListStorageKeysPerEntry = 1024
+)
+
+// validateAccessListTx checks an access-list tx's list for well-formedness
+// (no duplicate addresses, within size caps) and that the tx supplies at
+// least the intrinsic gas the list demands.
+func validateAccessListTx(trx *tx.Transaction) error {
+	al := trx.AccessList()
+	if len(al) > maxAccessListAddresses {
+		return txRejectedError{"access list too large"}
+	}
+
+	seen := make(map[thor.Address]bool, len(al))
+	for _, entry := range al {
+		if seen[entry.Address] {
+			return txRejectedError{"access list contains duplicate address"}
+		}
+		seen[entry.Address] = true
+		if len(entry.StorageKeys) > maxAccessListStorageKeysPerEntry {
+			return txRejectedError{"access list entry has too many storage keys"}
+		}
+	}
+
+	if trx.Gas() < accessListIntrinsicGas(al) {
+		return txRejectedError{"intrinsic gas too low"}
+	}
+	return nil
+}
+
+// accessListIntrinsicGas returns the minimum gas an access-list tx must
+// supply: the flat txGas plus a per-address and per-storage-key surcharge.
+func accessListIntrinsicGas(al tx.AccessList) uint64 {
+	gas := uint64(txGas)
+	for _, entry := range al {
+		gas += accessListAddressGas
+		gas += uint64(len(entry.StorageKeys)) * accessListStorageKeyGas
+	}
+	return gas
+}
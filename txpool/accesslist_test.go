@@ -0,0 +1,76 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/v2/chain"
+	"github.com/vechain/thor/v2/thor"
+	"github.com/vechain/thor/v2/tx"
+)
+
+func TestValidateAccessListTransaction(t *testing.T) {
+	repo := newChainRepo()
+
+	tests := []struct {
+		name        string
+		getTx       func() *tx.Transaction
+		head        *chain.BlockSummary
+		forkConfig  *thor.ForkConfig
+		expectedErr error
+	}{
+		{
+			name: "unsupported access list transaction type before Galactica fork",
+			getTx: func() *tx.Transaction {
+				return tx.NewBuilder(tx.TypeAccessList).ChainTag(repo.ChainTag()).Build()
+			},
+			head:        &chain.BlockSummary{Header: getHeader(1)},
+			forkConfig:  &thor.ForkConfig{GALACTICA: 10},
+			expectedErr: tx.ErrTxTypeNotSupported,
+		},
+		{
+			name: "oversized access list is rejected",
+			getTx: func() *tx.Transaction {
+				al := make(tx.AccessList, maxAccessListAddresses+1)
+				return tx.NewBuilder(tx.TypeAccessList).ChainTag(repo.ChainTag()).AccessList(al).Gas(10_000_000).Build()
+			},
+			head:        &chain.BlockSummary{Header: getHeader(100)},
+			forkConfig:  &thor.ForkConfig{GALACTICA: 10},
+			expectedErr: txRejectedError{"access list too large"},
+		},
+		{
+			name: "insufficient intrinsic gas for access list is rejected",
+			getTx: func() *tx.Transaction {
+				al := tx.AccessList{{Address: thor.Address{1}, StorageKeys: []thor.Bytes32{{1}}}}
+				return tx.NewBuilder(tx.TypeAccessList).ChainTag(repo.ChainTag()).AccessList(al).Gas(txGas).Build()
+			},
+			head:        &chain.BlockSummary{Header: getHeader(100)},
+			forkConfig:  &thor.ForkConfig{GALACTICA: 10},
+			expectedErr: txRejectedError{"intrinsic gas too low"},
+		},
+		{
+			name: "well-formed access list transaction is accepted",
+			getTx: func() *tx.Transaction {
+				al := tx.AccessList{{Address: thor.Address{1}, StorageKeys: []thor.Bytes32{{1}}}}
+				return tx.NewBuilder(tx.TypeAccessList).ChainTag(repo.ChainTag()).AccessList(al).
+					Gas(accessListIntrinsicGas(al)).MaxFeePerGas(big.NewInt(thor.InitialBaseFee + 1)).MaxPriorityFeePerGas(big.NewInt(1)).Build()
+			},
+			head:        &chain.BlockSummary{Header: getHeader(100)},
+			forkConfig:  &thor.ForkConfig{GALACTICA: 10},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTransaction(tt.getTx(), repo, tt.head, tt.forkConfig)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"sync"
+
+	"github.com/vechain/thor/v2/thor"
+)
+
+// blobSlotsPerAccount caps how many blob-carrying txs a single origin may have pending
+// at once, keeping blob traffic from crowding out the regular tx queue.
+const blobSlotsPerAccount = 16
+
+// blobAccounting tracks pending blob txs separately from the rest of the pool
+// so that a spammy blob sender can't starve non-blob txs out of their slots.
+type blobAccounting struct {
+	mu    sync.Mutex
+	slots map[thor.Address]int
+}
+
+func newBlobAccounting() *blobAccounting {
+	return &blobAccounting{slots: make(map[thor.Address]int)}
+}
+
+// reserve returns false, without reserving a slot, if origin already holds
+// blobSlotsPerAccount pending blob txs.
+func (b *blobAccounting) reserve(origin thor.Address) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.slots[origin] >= blobSlotsPerAccount {
+		return false
+	}
+	b.slots[origin]++
+	return true
+}
+
+// release frees a previously reserved slot, e.g. when the tx is evicted or mined.
+func (b *blobAccounting) release(origin thor.Address) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.slots[origin] > 0 {
+		b.slots[origin]--
+		if b.slots[origin] == 0 {
+			delete(b.slots, origin)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"github.com/vechain/thor/v2/chain"
+	"github.com/vechain/thor/v2/test/testchain"
+	"github.com/vechain/thor/v2/thor"
+)
+
+// newChainRepo spins up a throwaway repository for tests that only care
+// about stateless validation (chain tag, size, fork gating).
+func newChainRepo() *chain.Repository {
+	tchain, err := testchain.NewWithFork(&thor.NoFork)
+	if err != nil {
+		panic(err)
+	}
+	return tchain.Repo()
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package gasprice computes suggested dyn-fee gas prices from recent chain
+// history, combined with a floor derived from the pool's pending txs so the
+// suggestion actually clears the pool rather than just reflecting the past.
+package gasprice
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/vechain/thor/v2/chain"
+	"github.com/vechain/thor/v2/thor"
+	"github.com/vechain/thor/v2/tx"
+)
+
+// defaultMaxBlockHistory bounds how far back FeeHistory will walk when a
+// caller asks for more blocks than is reasonable to serve in one call.
+const defaultMaxBlockHistory = 1024
+
+// PendingPool is the subset of txpool.Pool the oracle needs to derive a
+// floor for the suggested priority fee from currently pending dyn-fee txs.
+type PendingPool interface {
+	Executables() tx.Transactions
+}
+
+// BlockFees summarizes one block's fee market for FeeHistory.
+type BlockFees struct {
+	BaseFee      *big.Int
+	GasUsedRatio float64
+	Rewards      []*big.Int
+}
+
+// cacheKey identifies a cached BlockFees by both the block it describes and
+// the percentiles it was computed for — two requests for the same block but
+// different percentiles must not collide.
+type cacheKey struct {
+	blockID     thor.Bytes32
+	percentiles string
+}
+
+func newCacheKey(blockID thor.Bytes32, percentiles []float64) cacheKey {
+	return cacheKey{blockID: blockID, percentiles: fmt.Sprint(percentiles)}
+}
+
+// Oracle suggests maxFeePerGas/maxPriorityFeePerGas from recent block history
+// and the pool's current backlog.
+type Oracle struct {
+	repo *chain.Repository
+	pool PendingPool
+
+	cache *lru.Cache[cacheKey, *BlockFees]
+}
+
+// New creates an Oracle that reads history from repo and floors its priority
+// fee suggestion using pool's currently pending dyn-fee transactions.
+func New(repo *chain.Repository, pool PendingPool) *Oracle {
+	return &Oracle{
+		repo:  repo,
+		pool:  pool,
+		cache: lru.NewCache[cacheKey, *BlockFees](defaultMaxBlockHistory),
+	}
+}
+
+// FeeHistory walks back blockCount blocks from the chain head and returns,
+// for each block, its base fee, gas-used ratio and the effective tip at each
+// requested percentile.
+func (o *Oracle) FeeHistory(blockCount int, percentiles []float64) ([]*BlockFees, error) {
+	if blockCount > defaultMaxBlockHistory {
+		blockCount = defaultMaxBlockHistory
+	}
+
+	best := o.repo.BestBlockSummary()
+	result := make([]*BlockFees, 0, blockCount)
+
+	id := best.Header.ID()
+	for i := 0; i < blockCount; i++ {
+		summary, err := o.repo.GetBlockSummary(id)
+		if err != nil {
+			return nil, err
+		}
+
+		key := newCacheKey(id, percentiles)
+		fees, ok := o.cache.Get(key)
+		if !ok {
+			fees = blockFees(summary, percentiles)
+			o.cache.Add(key, fees)
+		}
+		result = append(result, fees)
+
+		id = summary.Header.ParentID()
+		if id.IsZero() {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// SuggestPriorityFee returns the median effective tip observed over the last
+// blockCount blocks, floored at the median maxPriorityFeePerGas of the pool's
+// currently pending dyn-fee txs.
+func (o *Oracle) SuggestPriorityFee(blockCount int) (*big.Int, error) {
+	history, err := o.FeeHistory(blockCount, []float64{50})
+	if err != nil {
+		return nil, err
+	}
+
+	var tips []*big.Int
+	for _, fees := range history {
+		tips = append(tips, fees.Rewards...)
+	}
+	historical := medianBig(tips)
+
+	floor := o.pendingFloor()
+	if floor != nil && floor.Cmp(historical) > 0 {
+		return floor, nil
+	}
+	return historical, nil
+}
+
+// pendingFloor derives a priority fee that would clear the median of the
+// pool's currently pending dyn-fee txs, so suggestions track live demand and
+// not just what happened to land in recent blocks.
+func (o *Oracle) pendingFloor() *big.Int {
+	if o.pool == nil {
+		return nil
+	}
+
+	var tips []*big.Int
+	for _, trx := range o.pool.Executables() {
+		if trx.Type() != tx.TypeDynamicFee {
+			continue
+		}
+		tips = append(tips, trx.MaxPriorityFeePerGas())
+	}
+	return medianBig(tips)
+}
+
+func blockFees(summary *chain.BlockSummary, percentiles []float64) *BlockFees {
+	baseFee := summary.Header.BaseFee()
+	gasUsedRatio := float64(summary.Header.GasUsed()) / float64(summary.Header.GasLimit())
+
+	type tipAndGas struct {
+		tip *big.Int
+		gas uint64
+	}
+	weighted := make([]tipAndGas, 0, len(summary.Txs))
+	for _, trx := range summary.Txs {
+		weighted = append(weighted, tipAndGas{tip: effectiveTip(trx, baseFee), gas: trx.Gas()})
+	}
+	sort.Slice(weighted, func(i, j int) bool { return weighted[i].tip.Cmp(weighted[j].tip) < 0 })
+
+	var totalGas uint64
+	for _, w := range weighted {
+		totalGas += w.gas
+	}
+
+	rewards := make([]*big.Int, len(percentiles))
+	var cumGas uint64
+	pIdx := 0
+	for _, w := range weighted {
+		cumGas += w.gas
+		for pIdx < len(percentiles) && float64(cumGas) >= percentiles[pIdx]/100*float64(totalGas) {
+			rewards[pIdx] = w.tip
+			pIdx++
+		}
+	}
+	for ; pIdx < len(percentiles); pIdx++ {
+		rewards[pIdx] = new(big.Int)
+	}
+
+	return &BlockFees{BaseFee: baseFee, GasUsedRatio: gasUsedRatio, Rewards: rewards}
+}
+
+// effectiveTip returns the tip a tx actually pays the block producer at the
+// given base fee: min(maxPriorityFeePerGas, maxFeePerGas-baseFee) for
+// dyn-fee txs, and the GasPriceCoef-derived tip for legacy ones.
+func effectiveTip(trx *tx.Transaction, baseFee *big.Int) *big.Int {
+	if trx.Type() == tx.TypeLegacy {
+		return new(big.Int).Sub(trx.GasPrice(baseFee), baseFee)
+	}
+
+	headroom := new(big.Int).Sub(trx.MaxFeePerGas(), baseFee)
+	tip := trx.MaxPriorityFeePerGas()
+	if headroom.Cmp(tip) < 0 {
+		return headroom
+	}
+	return tip
+}
+
+func medianBig(nums []*big.Int) *big.Int {
+	if len(nums) == 0 {
+		return new(big.Int)
+	}
+	sorted := append([]*big.Int(nil), nums...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+	return sorted[len(sorted)/2]
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package gasprice
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/v2/block"
+	"github.com/vechain/thor/v2/chain"
+	"github.com/vechain/thor/v2/tx"
+)
+
+// newTestRepo builds a two-block chain: genesis plus one block carrying txs,
+// which blockFees/FeeHistory can be exercised against.
+func newTestRepo(txs tx.Transactions, baseFee *big.Int, gasUsed, gasLimit uint64) *chain.Repository {
+	genesis := new(block.Builder).Build()
+	repo := chain.NewRepository(0x01, genesis)
+
+	header := new(block.Builder).
+		ParentID(genesis.Header().ID()).
+		GasLimit(gasLimit).
+		GasUsed(gasUsed).
+		BaseFee(baseFee).
+		Build().Header()
+	repo.AddBlock(&chain.BlockSummary{Header: header, Txs: txs})
+
+	return repo
+}
+
+func TestEffectiveTip(t *testing.T) {
+	baseFee := big.NewInt(1000)
+
+	tests := []struct {
+		name     string
+		getTx    func() *tx.Transaction
+		expected *big.Int
+	}{
+		{
+			name: "dyn fee tx capped by headroom",
+			getTx: func() *tx.Transaction {
+				return tx.NewBuilder(tx.TypeDynamicFee).MaxFeePerGas(big.NewInt(1050)).MaxPriorityFeePerGas(big.NewInt(100)).Build()
+			},
+			expected: big.NewInt(50),
+		},
+		{
+			name: "dyn fee tx capped by priority fee",
+			getTx: func() *tx.Transaction {
+				return tx.NewBuilder(tx.TypeDynamicFee).MaxFeePerGas(big.NewInt(2000)).MaxPriorityFeePerGas(big.NewInt(100)).Build()
+			},
+			expected: big.NewInt(100),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, effectiveTip(tt.getTx(), baseFee))
+		})
+	}
+}
+
+func TestMedianBig(t *testing.T) {
+	assert.Equal(t, big.NewInt(0), medianBig(nil))
+	assert.Equal(t, big.NewInt(2), medianBig([]*big.Int{big.NewInt(3), big.NewInt(1), big.NewInt(2)}))
+}
+
+func TestBlockFeesPercentileBoundary(t *testing.T) {
+	baseFee := big.NewInt(1000)
+	txs := tx.Transactions{
+		tx.NewBuilder(tx.TypeDynamicFee).MaxFeePerGas(big.NewInt(1050)).MaxPriorityFeePerGas(big.NewInt(50)).Gas(30_000).Build(),
+		tx.NewBuilder(tx.TypeDynamicFee).MaxFeePerGas(big.NewInt(1200)).MaxPriorityFeePerGas(big.NewInt(200)).Gas(70_000).Build(),
+	}
+	summary := &chain.BlockSummary{
+		Header: new(block.Builder).GasUsed(60_000).GasLimit(100_000).BaseFee(baseFee).Build().Header(),
+		Txs:    txs,
+	}
+
+	fees := blockFees(summary, []float64{30, 50, 80})
+
+	assert.Equal(t, baseFee, fees.BaseFee)
+	assert.Equal(t, float64(60_000)/float64(100_000), fees.GasUsedRatio)
+	// Cumulative gas after the lower-tip tx (30_000) lands exactly on the
+	// 30th percentile of total tx gas (100_000); everything above it lands
+	// on the higher-tip tx's reward.
+	assert.Equal(t, []*big.Int{big.NewInt(50), big.NewInt(200), big.NewInt(200)}, fees.Rewards)
+}
+
+// TestFeeHistoryCachesByPercentiles guards against the cache-key collision
+// the chunk0-2 fix patched: two FeeHistory calls for the same block but
+// different percentile sets must not clobber each other's cached entry.
+func TestFeeHistoryCachesByPercentiles(t *testing.T) {
+	txs := tx.Transactions{
+		tx.NewBuilder(tx.TypeDynamicFee).MaxFeePerGas(big.NewInt(1100)).MaxPriorityFeePerGas(big.NewInt(50)).Gas(21_000).Build(),
+		tx.NewBuilder(tx.TypeDynamicFee).MaxFeePerGas(big.NewInt(2000)).MaxPriorityFeePerGas(big.NewInt(500)).Gas(21_000).Build(),
+	}
+	repo := newTestRepo(txs, big.NewInt(1000), 42_000, 20_000_000)
+	o := New(repo, nil)
+
+	median, err := o.FeeHistory(1, []float64{50})
+	assert.Nil(t, err)
+	assert.Len(t, median[0].Rewards, 1)
+
+	full, err := o.FeeHistory(1, []float64{25, 50, 75})
+	assert.Nil(t, err)
+	assert.Len(t, full[0].Rewards, 3)
+
+	// Re-requesting the 1-percentile set must still come back 1-length: the
+	// intervening 3-percentile request for the same block must not have
+	// overwritten this cache entry.
+	again, err := o.FeeHistory(1, []float64{50})
+	assert.Nil(t, err)
+	assert.Equal(t, median, again)
+}
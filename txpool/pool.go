@@ -0,0 +1,106 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package txpool collects and validates pending transactions.
+package txpool
+
+import (
+	"sync"
+
+	"github.com/vechain/thor/v2/chain"
+	"github.com/vechain/thor/v2/state"
+	"github.com/vechain/thor/v2/thor"
+	"github.com/vechain/thor/v2/tx"
+)
+
+// Pool holds transactions that are valid against the current chain state but
+// not yet included in a block.
+type Pool struct {
+	repo       *chain.Repository
+	forkConfig *thor.ForkConfig
+
+	mu      sync.Mutex
+	pending map[replacementKey]*tx.Transaction
+
+	blobs *blobAccounting
+}
+
+// New creates an empty pool backed by repo and gated by forkConfig.
+func New(repo *chain.Repository, forkConfig *thor.ForkConfig) *Pool {
+	return &Pool{
+		repo:       repo,
+		forkConfig: forkConfig,
+		pending:    make(map[replacementKey]*tx.Transaction),
+		blobs:      newBlobAccounting(),
+	}
+}
+
+// Add validates trx and, if it passes, admits it to the pool — either as a
+// new pending tx or as a replacement for an existing one occupying the same
+// slot, provided it clears the price bump.
+func (p *Pool) Add(trx *tx.Transaction, head *chain.BlockSummary, st *state.State) error {
+	if err := validateTransaction(trx, p.repo, head, p.forkConfig); err != nil {
+		return err
+	}
+	// Check the fee offer against the *next* block's projected base fee, not
+	// head's own, so a tx doesn't get stuck in the pool while it's rising.
+	next := projectedHeader(head, p.forkConfig)
+	if err := validateTransactionWithState(trx, next, p.forkConfig, st); err != nil {
+		return err
+	}
+
+	key, err := newReplacementKey(trx)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if old, ok := p.pending[key]; ok {
+		if err := validateReplacement(trx, old, next.BaseFee()); err != nil {
+			if _, replaced := err.(txReplacedError); !replaced {
+				return err
+			}
+			p.evictLocked(old)
+		}
+	}
+
+	if trx.Type() == tx.TypeBlob {
+		origin, err := trx.Origin()
+		if err != nil {
+			return err
+		}
+		if !p.blobs.reserve(origin) {
+			return txRejectedError{"too many pending blob txs for this account"}
+		}
+	}
+
+	p.pending[key] = trx
+	return nil
+}
+
+// Executables returns every pending transaction, satisfying
+// gasprice.PendingPool.
+func (p *Pool) Executables() tx.Transactions {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	out := make(tx.Transactions, 0, len(p.pending))
+	for _, trx := range p.pending {
+		out = append(out, trx)
+	}
+	return out
+}
+
+// evictLocked releases any blob slot trx held. It does not remove trx from
+// p.pending. Callers must hold p.mu.
+func (p *Pool) evictLocked(trx *tx.Transaction) {
+	if trx.Type() == tx.TypeBlob {
+		if origin, err := trx.Origin(); err == nil {
+			p.blobs.release(origin)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/v2/block"
+	"github.com/vechain/thor/v2/chain"
+	"github.com/vechain/thor/v2/consensus/fork"
+	"github.com/vechain/thor/v2/test/testchain"
+	"github.com/vechain/thor/v2/thor"
+	"github.com/vechain/thor/v2/trie"
+	"github.com/vechain/thor/v2/tx"
+)
+
+// TestPoolAddRejectsBlobTxBelowProjectedBlobBaseFee guards against
+// projectedHeader silently dropping ExcessBlobGas: if it did, every blob tx
+// would be checked against CalcBlobBaseFee(0) regardless of the chain's real
+// excess blob gas, and this test's underpriced tx would wrongly be accepted.
+func TestPoolAddRejectsBlobTxBelowProjectedBlobBaseFee(t *testing.T) {
+	tchain, err := testchain.NewWithFork(&thor.SoloFork)
+	assert.Nil(t, err)
+	repo := tchain.Repo()
+	stater := tchain.Stater()
+	st := stater.NewState(trie.Root{Hash: repo.GenesisBlock().Header().StateRoot()})
+
+	excessBlobGas := big.NewInt(10_000_000)
+	wantBlobBaseFee := fork.CalcBlobBaseFee(excessBlobGas)
+	assert.True(t, wantBlobBaseFee.Cmp(big.NewInt(1)) > 0, "fixture must exercise a non-trivial blob base fee")
+
+	head := &chain.BlockSummary{
+		Header: new(block.Builder).
+			ParentID(repo.GenesisBlock().Header().ID()).
+			TransactionFeatures(tx.Features(1)).
+			BaseFee(big.NewInt(thor.InitialBaseFee)).
+			ExcessBlobGas(excessBlobGas).
+			Build().Header(),
+	}
+	repo.AddBlock(head)
+
+	pool := New(repo, &thor.SoloFork)
+
+	underpriced := tx.NewBuilder(tx.TypeBlob).
+		ChainTag(repo.ChainTag()).
+		MaxFeePerGas(big.NewInt(thor.InitialBaseFee + 1)).
+		MaxFeePerBlobGas(new(big.Int).Sub(wantBlobBaseFee, big.NewInt(1))).
+		BlobVersionedHashes([]thor.Bytes32{{1}}).
+		Build()
+
+	err = pool.Add(underpriced, repo.BestBlockSummary(), st)
+	assert.Equal(t, txRejectedError{fmt.Sprintf("max fee per blob gas too low: expected %s got %s",
+		wantBlobBaseFee.String(), underpriced.MaxFeePerBlobGas().String())}, err)
+}
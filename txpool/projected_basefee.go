@@ -0,0 +1,32 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"github.com/vechain/thor/v2/block"
+	"github.com/vechain/thor/v2/chain"
+	"github.com/vechain/thor/v2/consensus/fork"
+	"github.com/vechain/thor/v2/thor"
+)
+
+// projectedHeader returns head's header with BaseFee replaced by the base
+// fee the *next* block is expected to carry, per fork.CalcBaseFee. Pending
+// dyn-fee txs are checked against this projection rather than head's own base
+// fee, so a tx doesn't get stuck in the pool while the base fee is rising.
+//
+// ExcessBlobGas carries over from head unchanged: this package doesn't track
+// blob gas actually used per block, so head's value is the best available
+// estimate of the next block's blob base fee floor.
+func projectedHeader(head *chain.BlockSummary, forkConfig *thor.ForkConfig) *block.Header {
+	nextBaseFee := fork.CalcBaseFee(head.Header, forkConfig)
+	return new(block.Builder).
+		ParentID(head.Header.ID()).
+		GasLimit(head.Header.GasLimit()).
+		TransactionFeatures(head.Header.TransactionFeatures()).
+		BaseFee(nextBaseFee).
+		ExcessBlobGas(head.Header.ExcessBlobGas()).
+		Build().Header()
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/vechain/thor/v2/thor"
+	"github.com/vechain/thor/v2/tx"
+)
+
+// priceBumpPercent is the minimum percentage by which a replacement tx's
+// effective gas price must exceed the incumbent's, matching go-ethereum's
+// default txpool price-bump.
+const priceBumpPercent = 10
+
+// txReplacedError is returned by validateReplacement, instead of a rejection, when newTx is
+// accepted specifically because it replaces an existing pending tx from the
+// same origin. Callers use it to distinguish "accepted as new" from
+// "accepted, evict the old one".
+type txReplacedError struct {
+	msg string
+}
+
+func (e txReplacedError) Error() string {
+	return e.msg
+}
+
+// replacementKey identifies the pending slot a tx occupies. Thor has no
+// account nonce, so same-origin txs are keyed by the (blockRef, expiration)
+// pair that plays the equivalent role.
+type replacementKey struct {
+	origin     thor.Address
+	blockRef   tx.BlockRef
+	expiration uint32
+}
+
+func newReplacementKey(trx *tx.Transaction) (replacementKey, error) {
+	origin, err := trx.Origin()
+	if err != nil {
+		return replacementKey{}, err
+	}
+	return replacementKey{origin: origin, blockRef: trx.BlockRef(), expiration: trx.Expiration()}, nil
+}
+
+// validateReplacement decides whether newTx may replace oldTx, which occupies
+// the same replacement slot. It returns:
+//   - nil, if newTx doesn't actually contend with oldTx's slot (caller should
+//     treat it as a brand new pending tx);
+//   - txRejectedError, if it contends but doesn't clear the price bump;
+//   - txReplacedError, if it contends and clears the bump, meaning oldTx must
+//     be evicted in favor of newTx.
+func validateReplacement(newTx, oldTx *tx.Transaction, baseFee *big.Int) error {
+	newKey, err := newReplacementKey(newTx)
+	if err != nil {
+		return err
+	}
+	oldKey, err := newReplacementKey(oldTx)
+	if err != nil {
+		return err
+	}
+	if newKey != oldKey {
+		return nil
+	}
+
+	if !priceBumps(newTx, oldTx, baseFee) {
+		return txRejectedError{"replacement transaction underpriced"}
+	}
+	return txReplacedError{fmt.Sprintf("replaces pending tx %s", oldTx.ID())}
+}
+
+// priceBumps reports whether newTx's effective gas price clears oldTx's by at
+// least priceBumpPercent. Two legacy txs compare their derived gas prices; two
+// non-legacy txs must each bump both maxFeePerGas and maxPriorityFeePerGas;
+// a legacy tx against a non-legacy one compares effectiveGasPrice (base fee
+// plus effective tip) at the given base fee.
+func priceBumps(newTx, oldTx *tx.Transaction, baseFee *big.Int) bool {
+	if newTx.Type() == tx.TypeLegacy && oldTx.Type() == tx.TypeLegacy {
+		return bumps(newTx.GasPrice(baseFee), oldTx.GasPrice(baseFee))
+	}
+	if newTx.Type() != tx.TypeLegacy && oldTx.Type() != tx.TypeLegacy {
+		return bumps(newTx.MaxFeePerGas(), oldTx.MaxFeePerGas()) &&
+			bumps(newTx.MaxPriorityFeePerGas(), oldTx.MaxPriorityFeePerGas())
+	}
+	return bumps(effectiveGasPrice(newTx, baseFee), effectiveGasPrice(oldTx, baseFee))
+}
+
+// effectiveGasPrice is what the tx actually pays per gas at baseFee, used to
+// compare legacy and dyn-fee txs on equal footing.
+func effectiveGasPrice(trx *tx.Transaction, baseFee *big.Int) *big.Int {
+	if trx.Type() == tx.TypeLegacy {
+		return trx.GasPrice(baseFee)
+	}
+	headroom := new(big.Int).Sub(trx.MaxFeePerGas(), baseFee)
+	tip := trx.MaxPriorityFeePerGas()
+	if headroom.Cmp(tip) < 0 {
+		return new(big.Int).Add(baseFee, headroom)
+	}
+	return new(big.Int).Add(baseFee, tip)
+}
+
+// bumps reports whether newPrice exceeds oldPrice by at least priceBumpPercent.
+func bumps(newPrice, oldPrice *big.Int) bool {
+	threshold := new(big.Int).Mul(oldPrice, big.NewInt(100+priceBumpPercent))
+	threshold.Div(threshold, big.NewInt(100))
+	return newPrice.Cmp(threshold) >= 0
+}
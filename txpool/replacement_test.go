@@ -0,0 +1,81 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/v2/tx"
+)
+
+func TestValidateReplacement(t *testing.T) {
+	baseFee := big.NewInt(1000)
+
+	tests := []struct {
+		name        string
+		oldTx       func() *tx.Transaction
+		newTx       func() *tx.Transaction
+		expectedErr error
+	}{
+		{
+			name:        "legacy underpriced replace is rejected",
+			oldTx:       func() *tx.Transaction { return tx.NewBuilder(tx.TypeLegacy).GasPriceCoef(100).Build() },
+			newTx:       func() *tx.Transaction { return tx.NewBuilder(tx.TypeLegacy).GasPriceCoef(101).Build() },
+			expectedErr: txRejectedError{"replacement transaction underpriced"},
+		},
+		{
+			name: "dyn fee exact bump boundary (old=100 new=110) is accepted",
+			oldTx: func() *tx.Transaction {
+				return tx.NewBuilder(tx.TypeDynamicFee).MaxFeePerGas(big.NewInt(100)).MaxPriorityFeePerGas(big.NewInt(100)).Build()
+			},
+			newTx: func() *tx.Transaction {
+				return tx.NewBuilder(tx.TypeDynamicFee).MaxFeePerGas(big.NewInt(110)).MaxPriorityFeePerGas(big.NewInt(110)).Build()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "dyn fee one bump short (old=100 new=109) is rejected",
+			oldTx: func() *tx.Transaction {
+				return tx.NewBuilder(tx.TypeDynamicFee).MaxFeePerGas(big.NewInt(100)).MaxPriorityFeePerGas(big.NewInt(100)).Build()
+			},
+			newTx: func() *tx.Transaction {
+				return tx.NewBuilder(tx.TypeDynamicFee).MaxFeePerGas(big.NewInt(109)).MaxPriorityFeePerGas(big.NewInt(109)).Build()
+			},
+			expectedErr: txRejectedError{"replacement transaction underpriced"},
+		},
+		{
+			name: "dyn fee cross-type replace clearing the bump is accepted",
+			oldTx: func() *tx.Transaction {
+				return tx.NewBuilder(tx.TypeLegacy).GasPriceCoef(0).Build()
+			},
+			newTx: func() *tx.Transaction {
+				return tx.NewBuilder(tx.TypeDynamicFee).MaxFeePerGas(big.NewInt(2000)).MaxPriorityFeePerGas(big.NewInt(1000)).Build()
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldTx, newTx := tt.oldTx(), tt.newTx()
+			err := validateReplacement(newTx, oldTx, baseFee)
+			if tt.expectedErr == nil {
+				if err != nil {
+					assert.IsType(t, txReplacedError{}, err)
+				}
+				return
+			}
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestBumps(t *testing.T) {
+	assert.True(t, bumps(big.NewInt(110), big.NewInt(100)))
+	assert.False(t, bumps(big.NewInt(109), big.NewInt(100)))
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"fmt"
+
+	"github.com/vechain/thor/v2/block"
+	"github.com/vechain/thor/v2/chain"
+	"github.com/vechain/thor/v2/consensus/fork"
+	"github.com/vechain/thor/v2/state"
+	"github.com/vechain/thor/v2/thor"
+	"github.com/vechain/thor/v2/tx"
+)
+
+// maxTxSize is the largest RLP-encoded size a transaction may have to be
+// admitted into the pool, regardless of type.
+const maxTxSize = 256 * 1024
+
+// maxBlobsPerTx bounds how many blobs a single blob transaction may carry,
+// mirroring the network-wide per-block blob limit so one tx can't eat it alone.
+const maxBlobsPerTx = 6
+
+// badTxError indicates a transaction that is malformed in a way that can
+// never be cured by a state or fork change, e.g. a wrong chain tag.
+type badTxError struct {
+	msg string
+}
+
+func (e badTxError) Error() string {
+	return e.msg
+}
+
+// txRejectedError indicates a well-formed transaction that is nonetheless
+// rejected against the current chain state or fork configuration.
+type txRejectedError struct {
+	msg string
+}
+
+func (e txRejectedError) Error() string {
+	return e.msg
+}
+
+// validateTransaction performs stateless checks that don't require chain state,
+// such as chain tag, size, type-gating against the active fork and tx features.
+func validateTransaction(trx *tx.Transaction, repo *chain.Repository, head *chain.BlockSummary, forkConfig *thor.ForkConfig) error {
+	if trx.ChainTag() != repo.ChainTag() {
+		return badTxError{"chain tag mismatch"}
+	}
+	if trx.Size() > maxTxSize {
+		return txRejectedError{"size too large"}
+	}
+
+	switch trx.Type() {
+	case tx.TypeDynamicFee:
+		if head.Header.Number() < forkConfig.GALACTICA {
+			return tx.ErrTxTypeNotSupported
+		}
+	case tx.TypeAccessList:
+		if head.Header.Number() < forkConfig.GALACTICA {
+			return tx.ErrTxTypeNotSupported
+		}
+		if err := validateAccessListTx(trx); err != nil {
+			return err
+		}
+	case tx.TypeBlob:
+		// Blob txs ride in on the same fork as dyn-fee txs.
+		if head.Header.Number() < forkConfig.GALACTICA {
+			return tx.ErrTxTypeNotSupported
+		}
+		if err := validateBlobTx(trx); err != nil {
+			return err
+		}
+	}
+
+	if !trx.Features().IsSupported(head.Header.TransactionFeatures()) {
+		return txRejectedError{"unsupported features"}
+	}
+
+	return nil
+}
+
+// validateBlobTx checks blob-specific well-formedness that doesn't depend on
+// chain state: the tx must carry at least one blob and no more than maxBlobsPerTx.
+func validateBlobTx(trx *tx.Transaction) error {
+	n := len(trx.BlobVersionedHashes())
+	if n == 0 {
+		return txRejectedError{"no blobs"}
+	}
+	if n > maxBlobsPerTx {
+		return txRejectedError{"too many blobs"}
+	}
+	return nil
+}
+
+// validateTransactionWithState performs checks that require the current block
+// header and world state, namely that the tx's fee offer clears the block's
+// base fee (and, for blob txs, the blob base fee) once Galactica is active.
+func validateTransactionWithState(trx *tx.Transaction, header *block.Header, forkConfig *thor.ForkConfig, st *state.State) error {
+	if header.Number() < forkConfig.GALACTICA || trx.Type() == tx.TypeLegacy {
+		return nil
+	}
+
+	baseFee := header.BaseFee()
+	maxFeePerGas := trx.MaxFeePerGas()
+	if maxFeePerGas.Cmp(baseFee) < 0 {
+		return txRejectedError{fmt.Sprintf("%s: expected %s got %s", fork.ErrGasPriceTooLowForBlockBase.Error(), baseFee.String(), maxFeePerGas.String())}
+	}
+
+	if trx.Type() == tx.TypeBlob {
+		blobBaseFee := fork.CalcBlobBaseFee(header.ExcessBlobGas())
+		maxFeePerBlobGas := trx.MaxFeePerBlobGas()
+		if maxFeePerBlobGas.Cmp(blobBaseFee) < 0 {
+			return txRejectedError{fmt.Sprintf("max fee per blob gas too low: expected %s got %s", blobBaseFee.String(), maxFeePerBlobGas.String())}
+		}
+	}
+
+	return nil
+}
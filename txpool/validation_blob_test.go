@@ -0,0 +1,128 @@
+// Copyright (c) 2024 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/v2/block"
+	"github.com/vechain/thor/v2/chain"
+	"github.com/vechain/thor/v2/consensus/fork"
+	"github.com/vechain/thor/v2/test/testchain"
+	"github.com/vechain/thor/v2/thor"
+	"github.com/vechain/thor/v2/trie"
+	"github.com/vechain/thor/v2/tx"
+)
+
+func TestValidateBlobTransaction(t *testing.T) {
+	repo := newChainRepo()
+
+	tests := []struct {
+		name        string
+		getTx       func() *tx.Transaction
+		head        *chain.BlockSummary
+		forkConfig  *thor.ForkConfig
+		expectedErr error
+	}{
+		{
+			name: "unsupported blob transaction type before Galactica fork",
+			getTx: func() *tx.Transaction {
+				return tx.NewBuilder(tx.TypeBlob).ChainTag(repo.ChainTag()).BlobVersionedHashes([]thor.Bytes32{{1}}).Build()
+			},
+			head:        &chain.BlockSummary{Header: getHeader(1)},
+			forkConfig:  &thor.ForkConfig{GALACTICA: 10},
+			expectedErr: tx.ErrTxTypeNotSupported,
+		},
+		{
+			name: "supported blob transaction type after Galactica fork",
+			getTx: func() *tx.Transaction {
+				return tx.NewBuilder(tx.TypeBlob).ChainTag(repo.ChainTag()).BlobVersionedHashes([]thor.Bytes32{{1}}).
+					MaxFeePerGas(big.NewInt(1000)).MaxPriorityFeePerGas(big.NewInt(10)).MaxFeePerBlobGas(big.NewInt(1)).Build()
+			},
+			head:        &chain.BlockSummary{Header: getHeader(100)},
+			forkConfig:  &thor.ForkConfig{GALACTICA: 10},
+			expectedErr: nil,
+		},
+		{
+			name: "blob transaction with no blobs",
+			getTx: func() *tx.Transaction {
+				return tx.NewBuilder(tx.TypeBlob).ChainTag(repo.ChainTag()).Build()
+			},
+			head:        &chain.BlockSummary{Header: getHeader(100)},
+			forkConfig:  &thor.ForkConfig{GALACTICA: 10},
+			expectedErr: txRejectedError{"no blobs"},
+		},
+		{
+			name: "blob transaction with too many blobs",
+			getTx: func() *tx.Transaction {
+				hashes := make([]thor.Bytes32, maxBlobsPerTx+1)
+				return tx.NewBuilder(tx.TypeBlob).ChainTag(repo.ChainTag()).BlobVersionedHashes(hashes).Build()
+			},
+			head:        &chain.BlockSummary{Header: getHeader(100)},
+			forkConfig:  &thor.ForkConfig{GALACTICA: 10},
+			expectedErr: txRejectedError{"too many blobs"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTransaction(tt.getTx(), repo, tt.head, tt.forkConfig)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestValidateBlobTransactionWithState(t *testing.T) {
+	tchain, err := testchain.NewWithFork(&thor.SoloFork)
+	assert.Nil(t, err)
+	repo := tchain.Repo()
+	stater := tchain.Stater()
+	state := stater.NewState(trie.Root{Hash: repo.GenesisBlock().Header().StateRoot()})
+
+	tests := []struct {
+		name        string
+		getTx       func() *tx.Transaction
+		header      *block.Header
+		expectedErr error
+	}{
+		{
+			name: "maxFeePerBlobGas equals blobBaseFee - 1 is rejected",
+			getTx: func() *tx.Transaction {
+				blobBaseFee := fork.CalcBlobBaseFee(getHeader(1).ExcessBlobGas())
+				maxFeePerBlobGas := new(big.Int).Sub(blobBaseFee, big.NewInt(1))
+				return tx.NewBuilder(tx.TypeBlob).ChainTag(repo.ChainTag()).
+					MaxFeePerGas(big.NewInt(thor.InitialBaseFee + 1)).MaxPriorityFeePerGas(big.NewInt(1)).
+					BlobVersionedHashes([]thor.Bytes32{{1}}).MaxFeePerBlobGas(maxFeePerBlobGas).Build()
+			},
+			header: getHeader(1),
+			expectedErr: txRejectedError{fmt.Sprintf("max fee per blob gas too low: expected %s got %s",
+				fork.CalcBlobBaseFee(getHeader(1).ExcessBlobGas()).String(),
+				new(big.Int).Sub(fork.CalcBlobBaseFee(getHeader(1).ExcessBlobGas()), big.NewInt(1)).String())},
+		},
+		{
+			name: "maxFeePerBlobGas equals blobBaseFee + 1 is accepted",
+			getTx: func() *tx.Transaction {
+				blobBaseFee := fork.CalcBlobBaseFee(getHeader(1).ExcessBlobGas())
+				maxFeePerBlobGas := new(big.Int).Add(blobBaseFee, big.NewInt(1))
+				return tx.NewBuilder(tx.TypeBlob).ChainTag(repo.ChainTag()).
+					MaxFeePerGas(big.NewInt(thor.InitialBaseFee + 1)).MaxPriorityFeePerGas(big.NewInt(1)).
+					BlobVersionedHashes([]thor.Bytes32{{1}}).MaxFeePerBlobGas(maxFeePerBlobGas).Build()
+			},
+			header:      getHeader(1),
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTransactionWithState(tt.getTx(), tt.header, &thor.ForkConfig{GALACTICA: 0}, state)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
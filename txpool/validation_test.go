@@ -51,7 +51,7 @@ func TestValidateTransaction(t *testing.T) {
 			getTx: func() *tx.Transaction {
 				b := tx.NewBuilder(tx.TypeLegacy).ChainTag(repo.ChainTag())
 				// Including a lot of clauses to increase the size above the max allowed
-				for range 50_000 {
+				for i := 0; i < 50_000; i++ {
 					b.Clause(&tx.Clause{})
 				}
 				return b.Build()
@@ -65,7 +65,7 @@ func TestValidateTransaction(t *testing.T) {
 			getTx: func() *tx.Transaction {
 				b := tx.NewBuilder(tx.TypeDynamicFee).ChainTag(repo.ChainTag())
 				// Including a lot of clauses to increase the size above the max allowed
-				for range 50_000 {
+				for i := 0; i < 50_000; i++ {
 					b.Clause(&tx.Clause{})
 				}
 				return b.Build()